@@ -122,6 +122,16 @@ type userInterfaceImpl struct {
 	fpsMode         int32
 	renderRequester RenderRequester
 
+	orientation                int32
+	orientationChangedCallback atomic.Value // func(orientation DeviceOrientation)
+
+	textInputActive                    bool
+	textInputRect                      image.Rectangle
+	textInputText                      string
+	textInputCompositionSelectionStart int
+	textInputCompositionSelectionEnd   int
+	textInputRequester                 TextInputRequester
+
 	renderThread *thread.OSThread
 
 	m sync.RWMutex
@@ -165,6 +175,7 @@ func (u *UserInterface) appMain(a app.App) {
 			}
 		case size.Event:
 			u.setGBuildSize(e.WidthPx, e.HeightPx)
+			u.setDeviceOrientation(deviceOrientationFromGomobile(e.Orientation))
 			sizeInited = true
 		case paint.Event:
 			if !sizeInited {
@@ -195,6 +206,12 @@ func (u *UserInterface) appMain(a app.App) {
 			}
 			updateInput = true
 		case key.Event:
+			// NOTE: if gbuildKeyToUIKey maps the Android back button and menu key, the
+			// game can read their state like any other key here. That alone does not stop
+			// the Android activity from also handling back/onBackPressed natively; doing
+			// that requires intercepting the key in the activity's onKeyDown/
+			// dispatchKeyEvent and routing it through the bridge instead, which is outside
+			// this package.
 			k, ok := gbuildKeyToUIKey[e.Code]
 			if ok {
 				switch e.Direction {
@@ -339,9 +356,8 @@ func (u *UserInterface) update() error {
 }
 
 func (u *UserInterface) ScreenSizeInFullscreen() (int, int) {
-	// TODO: This function should return gbuildWidthPx, gbuildHeightPx,
-	// but these values are not initialized until the main loop starts.
-	return 0, 0
+	b := theMonitor.Bounds()
+	return b.Dx(), b.Dy()
 }
 
 // SetOutsideSize is called from mobile/ebitenmobileview.
@@ -356,12 +372,37 @@ func (u *UserInterface) SetOutsideSize(outsideWidth, outsideHeight float64) {
 	u.m.Unlock()
 }
 
+// SetScreenBoundsInPixels is called from mobile/ebitenmobileview with the real, on-screen
+// monitor rectangle in device pixels, e.g. as reported by the Android Window or the iOS
+// UIScreen, so that Monitor.Bounds() no longer has to return an empty rectangle.
+//
+// SetScreenBoundsInPixels is concurrent safe.
+func (u *UserInterface) SetScreenBoundsInPixels(bounds image.Rectangle) {
+	theMonitor.setBounds(bounds)
+}
+
+// SetSafeAreaInsets is the entry point through which mobile/ebitenmobileview is expected to
+// report the insets that are unsafe to place a HUD in, e.g. WindowInsets on Android or
+// safeAreaInsets on iOS. The insets are in device pixels, measured from the corresponding
+// edge of the monitor bounds.
+//
+// As of this change there is no in-tree caller yet: wiring WindowInsets/safeAreaInsets
+// through to this method on the Java/Objective-C side is left for the mobile/ebitenmobileview
+// integration, so SafeAreaInsets() reports zero insets until that is done.
+//
+// SetSafeAreaInsets is concurrent safe.
+func (u *UserInterface) SetSafeAreaInsets(top, left, bottom, right int) {
+	theMonitor.setSafeAreaInsets(top, left, bottom, right)
+}
+
 func (u *UserInterface) setGBuildSize(widthPx, heightPx int) {
 	u.m.Lock()
 	u.gbuildWidthPx = widthPx
 	u.gbuildHeightPx = heightPx
 	u.m.Unlock()
 
+	theMonitor.setBounds(image.Rect(0, 0, widthPx, heightPx))
+
 	u.once.Do(func() {
 		close(u.setGBuildSizeCh)
 	})
@@ -419,6 +460,55 @@ func (u *UserInterface) updateExplicitRenderingModeIfNeeded(fpsMode FPSModeType)
 	u.renderRequester.SetExplicitRenderingMode(fpsMode == FPSModeVsyncOffMinimum)
 }
 
+// DeviceOrientation represents the physical orientation of a mobile device.
+type DeviceOrientation int
+
+const (
+	DeviceOrientationUnknown DeviceOrientation = iota
+	DeviceOrientationPortrait
+	DeviceOrientationLandscape
+)
+
+func deviceOrientationFromGomobile(o size.Orientation) DeviceOrientation {
+	switch o {
+	case size.OrientationPortrait:
+		return DeviceOrientationPortrait
+	case size.OrientationLandscape:
+		return DeviceOrientationLandscape
+	default:
+		return DeviceOrientationUnknown
+	}
+}
+
+// DeviceOrientation returns the device's current physical orientation.
+func (u *UserInterface) DeviceOrientation() DeviceOrientation {
+	return DeviceOrientation(atomic.LoadInt32(&u.orientation))
+}
+
+// SetDeviceOrientation is called from mobile/ebitenmobileview when the host reports an
+// orientation change outside of gomobile-build's size.Event stream.
+//
+// SetDeviceOrientation is concurrent safe.
+func (u *UserInterface) SetDeviceOrientation(orientation DeviceOrientation) {
+	u.setDeviceOrientation(orientation)
+}
+
+// SetDeviceOrientationChangedCallback sets a function to be called whenever
+// DeviceOrientation changes, e.g. so that a game can re-layout its HUD on rotation.
+func (u *UserInterface) SetDeviceOrientationChangedCallback(f func(orientation DeviceOrientation)) {
+	u.orientationChangedCallback.Store(f)
+}
+
+func (u *UserInterface) setDeviceOrientation(orientation DeviceOrientation) {
+	old := DeviceOrientation(atomic.SwapInt32(&u.orientation, int32(orientation)))
+	if old == orientation {
+		return
+	}
+	if f, ok := u.orientationChangedCallback.Load().(func(DeviceOrientation)); ok && f != nil {
+		f(orientation)
+	}
+}
+
 func (u *UserInterface) DeviceScaleFactor() float64 {
 	// Assume that the device scale factor never changes on mobiles.
 	u.deviceScaleFactorOnce.Do(func() {
@@ -437,13 +527,140 @@ func (u *UserInterface) Window() Window {
 	return &nullWindow{}
 }
 
-type Monitor struct{}
+// TextInputState represents the state of the on-screen text input session, including any
+// text the platform IME has composed but not yet committed.
+type TextInputState struct {
+	Active                    bool
+	Rect                      image.Rectangle
+	Text                      string
+	CompositionSelectionStart int
+	CompositionSelectionEnd   int
+}
+
+// TextInputRequester is notified when the game wants to show or hide the platform's soft
+// keyboard / IME. It is meant to be implemented by mobile/ebitenmobileview, forwarding the
+// calls to showSoftInput/resignFirstResponder on the Android/iOS side.
+//
+// When no requester is registered via SetTextInputRequester, StartTextInput and
+// StopTextInput only update the state returned by TextInputState; no soft keyboard is
+// shown or hidden.
+type TextInputRequester interface {
+	ShowSoftInput(rect image.Rectangle, initial string)
+	HideSoftInput()
+}
+
+// SetTextInputRequester sets the requester used to show and hide the platform's soft
+// keyboard.
+func (u *UserInterface) SetTextInputRequester(requester TextInputRequester) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.textInputRequester = requester
+}
+
+// StartTextInput requests the platform IME, anchored at rect (in device pixels), and
+// pre-fills it with initial text.
+func (u *UserInterface) StartTextInput(rect image.Rectangle, initial string) {
+	u.m.Lock()
+	u.textInputActive = true
+	u.textInputRect = rect
+	u.textInputText = initial
+	requester := u.textInputRequester
+	u.m.Unlock()
+
+	if requester != nil {
+		requester.ShowSoftInput(rect, initial)
+	}
+}
+
+// StopTextInput dismisses the platform IME opened by StartTextInput.
+func (u *UserInterface) StopTextInput() {
+	u.m.Lock()
+	u.textInputActive = false
+	requester := u.textInputRequester
+	u.m.Unlock()
+
+	if requester != nil {
+		requester.HideSoftInput()
+	}
+}
+
+// TextInputState returns the current state of the text input session started by
+// StartTextInput.
+func (u *UserInterface) TextInputState() TextInputState {
+	u.m.RLock()
+	defer u.m.RUnlock()
+	return TextInputState{
+		Active:                    u.textInputActive,
+		Rect:                      u.textInputRect,
+		Text:                      u.textInputText,
+		CompositionSelectionStart: u.textInputCompositionSelectionStart,
+		CompositionSelectionEnd:   u.textInputCompositionSelectionEnd,
+	}
+}
+
+// UpdateTextInputFromOutside is called from mobile/ebitenmobileview to report the platform
+// IME's current, not-yet-committed composition text, e.g. while the user is still choosing
+// kana or pinyin candidates. It only updates the snapshot returned by TextInputState; it
+// does not feed runes into the game's regular input.
+//
+// Once characters are committed, they are ordinary input: the host delivers them the same
+// way it delivers hardware key runes, by calling UpdateInput with the committed characters
+// in its runes argument, which reaches the game through updateInputStateFromOutside like
+// any other rune.
+//
+// UpdateTextInputFromOutside is concurrent safe.
+func (u *UserInterface) UpdateTextInputFromOutside(compositionText string, compositionSelectionStart, compositionSelectionEnd int) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	if !u.textInputActive {
+		return
+	}
+	u.textInputText = compositionText
+	u.textInputCompositionSelectionStart = compositionSelectionStart
+	u.textInputCompositionSelectionEnd = compositionSelectionEnd
+}
+
+type Monitor struct {
+	m sync.RWMutex
+
+	boundsInPixels image.Rectangle
+
+	safeAreaInsetTop    int
+	safeAreaInsetLeft   int
+	safeAreaInsetBottom int
+	safeAreaInsetRight  int
+}
 
 var theMonitor = &Monitor{}
 
 func (m *Monitor) Bounds() image.Rectangle {
-	// TODO: This should return the available viewport dimensions.
-	return image.Rectangle{}
+	m.m.RLock()
+	defer m.m.RUnlock()
+	return m.boundsInPixels
+}
+
+func (m *Monitor) setBounds(bounds image.Rectangle) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.boundsInPixels = bounds
+}
+
+// SafeAreaInsets returns the areas, in device pixels measured from the respective edge of
+// Bounds, that are obstructed by notches, punch-holes, rounded corners, or gesture bars and
+// so are unsafe to place a HUD in.
+func (m *Monitor) SafeAreaInsets() (top, left, bottom, right int) {
+	m.m.RLock()
+	defer m.m.RUnlock()
+	return m.safeAreaInsetTop, m.safeAreaInsetLeft, m.safeAreaInsetBottom, m.safeAreaInsetRight
+}
+
+func (m *Monitor) setSafeAreaInsets(top, left, bottom, right int) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.safeAreaInsetTop = top
+	m.safeAreaInsetLeft = left
+	m.safeAreaInsetBottom = bottom
+	m.safeAreaInsetRight = right
 }
 
 func (m *Monitor) Name() string {